@@ -0,0 +1,49 @@
+package types
+
+import "fmt"
+
+// CosmosMsgVersion identifies the CosmWasm CosmosMsg schema a contract was compiled against,
+// since v010 and v1 contracts encode SubMsg/Reply differently on the wasm<->Go boundary.
+type CosmosMsgVersion int
+
+const (
+	CosmosMsgVersionV010 CosmosMsgVersion = iota
+	CosmosMsgVersionV1
+)
+
+// VerificationInfo carries the original tx signing material needed to decide whether a reply
+// or submessage callback should be treated as signed (and therefore encrypted) or plaintext.
+type VerificationInfo struct {
+	Bytes     []byte
+	ModeInfo  []byte
+	PublicKey []byte
+	Signature []byte
+	SignMode  string
+}
+
+// SystemError is returned by the enclave/VM for failures that are guaranteed deterministic
+// across nodes (as opposed to SDK errors, whose string wording is not consensus-safe).
+type SystemError struct {
+	msg string
+}
+
+func (e SystemError) Error() string {
+	return fmt.Sprintf("system error: %s", e.msg)
+}
+
+// NewSystemError constructs a SystemError with the given message
+func NewSystemError(msg string) SystemError {
+	return SystemError{msg: msg}
+}
+
+// ToSystemError returns err as a *SystemError if err is a SystemError, or nil otherwise; an error
+// that merely wraps a SystemError is not unwrapped and yields nil
+func ToSystemError(err error) *SystemError {
+	if err == nil {
+		return nil
+	}
+	if se, ok := err.(SystemError); ok {
+		return &se
+	}
+	return nil
+}
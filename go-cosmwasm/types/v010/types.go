@@ -0,0 +1,7 @@
+package v010
+
+// LogAttribute is a key/value pair emitted by a v0.10 contract, mirroring the wasmvm wire format.
+type LogAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
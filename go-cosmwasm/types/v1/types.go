@@ -0,0 +1,215 @@
+package v1
+
+import (
+	v010 "github.com/enigmampc/SecretNetwork/go-cosmwasm/types/v010"
+)
+
+// CosmosMsg mirrors the Rust `cosmwasm_std::CosmosMsg` enum on the Go side of the wasm boundary.
+// Exactly one field is expected to be non-nil per message; DispatchMsg switches on which one.
+type CosmosMsg struct {
+	Bank     *BankMsg     `json:"bank,omitempty"`
+	Custom   *RawMessage  `json:"custom,omitempty"`
+	Staking  *StakingMsg  `json:"staking,omitempty"`
+	Distribution *DistributionMsg `json:"distribution,omitempty"`
+	Stargate *StargateMsg `json:"stargate,omitempty"`
+	// Any is the newer, stable replacement for Stargate: same shape (type URL + raw protobuf
+	// bytes), routed through the same allow-list/decode path.
+	Any      *AnyMsg      `json:"any,omitempty"`
+	Ibc      *IBCMsg      `json:"ibc,omitempty"`
+	Wasm     *WasmMsg     `json:"wasm,omitempty"`
+	Gov      *GovMsg      `json:"gov,omitempty"`
+}
+
+// RawMessage is an opaque, not-yet-decoded JSON payload, used for custom messages and the msg of
+// wasm execute/instantiate messages.
+type RawMessage []byte
+
+// BankMsg mirrors `cosmwasm_std::BankMsg`
+type BankMsg struct {
+	Send *SendMsg `json:"send,omitempty"`
+}
+
+// SendMsg is the payload of BankMsg::Send
+type SendMsg struct {
+	ToAddress string     `json:"to_address"`
+	Amount    []sdkCoin  `json:"amount"`
+}
+
+type sdkCoin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// StakingMsg mirrors `cosmwasm_std::StakingMsg`
+type StakingMsg struct {
+	Delegate   *DelegateMsg   `json:"delegate,omitempty"`
+	Undelegate *UndelegateMsg `json:"undelegate,omitempty"`
+	Redelegate *RedelegateMsg `json:"redelegate,omitempty"`
+}
+
+type DelegateMsg struct {
+	Validator string  `json:"validator"`
+	Amount    sdkCoin `json:"amount"`
+}
+
+type UndelegateMsg struct {
+	Validator string  `json:"validator"`
+	Amount    sdkCoin `json:"amount"`
+}
+
+type RedelegateMsg struct {
+	SrcValidator string  `json:"src_validator"`
+	DstValidator string  `json:"dst_validator"`
+	Amount       sdkCoin `json:"amount"`
+}
+
+// DistributionMsg mirrors `cosmwasm_std::DistributionMsg`
+type DistributionMsg struct {
+	SetWithdrawAddress      *SetWithdrawAddressMsg      `json:"set_withdraw_address,omitempty"`
+	WithdrawDelegatorReward *WithdrawDelegatorRewardMsg `json:"withdraw_delegator_reward,omitempty"`
+}
+
+type SetWithdrawAddressMsg struct {
+	Address string `json:"address"`
+}
+
+type WithdrawDelegatorRewardMsg struct {
+	Validator string `json:"validator"`
+}
+
+// StargateMsg mirrors `cosmwasm_std::CosmosMsg::Stargate` - an escape hatch to call arbitrary
+// chain modules by their protobuf type URL, gated by the compute module's Stargate allow-list.
+type StargateMsg struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+// AnyMsg mirrors `cosmwasm_std::CosmosMsg::Any` - identical wire shape to StargateMsg.
+type AnyMsg struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+// GovMsg mirrors `cosmwasm_std::GovMsg`
+type GovMsg struct {
+	Vote *VoteMsg `json:"vote,omitempty"`
+}
+
+type VoteMsg struct {
+	ProposalID uint64 `json:"proposal_id"`
+	Vote       string `json:"vote"`
+}
+
+// WasmMsg mirrors `cosmwasm_std::WasmMsg`
+type WasmMsg struct {
+	Execute     *ExecuteMsg     `json:"execute,omitempty"`
+	Instantiate *InstantiateMsg `json:"instantiate,omitempty"`
+}
+
+type ExecuteMsg struct {
+	ContractAddr string    `json:"contract_addr"`
+	Msg          RawMessage `json:"msg"`
+	SendAmount   []sdkCoin `json:"send,omitempty"`
+}
+
+type InstantiateMsg struct {
+	CodeID     uint64    `json:"code_id"`
+	Msg        RawMessage `json:"msg"`
+	SendAmount []sdkCoin `json:"send,omitempty"`
+	Label      string    `json:"label"`
+}
+
+// IBCMsg mirrors `cosmwasm_std::IbcMsg`
+type IBCMsg struct {
+	Transfer     *TransferMsg     `json:"transfer,omitempty"`
+	SendPacket   *SendPacketMsg   `json:"send_packet,omitempty"`
+	CloseChannel *CloseChannelMsg `json:"close_channel,omitempty"`
+}
+
+type TransferMsg struct {
+	ChannelID string  `json:"channel_id"`
+	ToAddress string  `json:"to_address"`
+	Amount    sdkCoin `json:"amount"`
+	Timeout   IBCTimeout `json:"timeout"`
+}
+
+type SendPacketMsg struct {
+	ChannelID string     `json:"channel_id"`
+	Data      []byte     `json:"data"`
+	Timeout   IBCTimeout `json:"timeout"`
+}
+
+type CloseChannelMsg struct {
+	ChannelID string `json:"channel_id"`
+}
+
+type IBCTimeout struct {
+	Block    *IBCTimeoutBlock `json:"block,omitempty"`
+	Timestamp uint64          `json:"timestamp,omitempty"`
+}
+
+type IBCTimeoutBlock struct {
+	Revision uint64 `json:"revision"`
+	Height   uint64 `json:"height"`
+}
+
+// ReplyOn controls under which circumstances the dispatcher calls back into the contract's reply
+// entry point after executing a SubMsg.
+type ReplyOn string
+
+const (
+	ReplyAlways  ReplyOn = "always"
+	ReplySuccess ReplyOn = "success"
+	ReplyError   ReplyOn = "error"
+	ReplyNever   ReplyOn = "never"
+)
+
+// SubMsg mirrors `cosmwasm_std::SubMsg` - a CosmosMsg dispatched in a sandboxed sub-context whose
+// result (and optionally a Reply) is returned to the calling contract.
+type SubMsg struct {
+	ID       uint64    `json:"id"`
+	Msg      CosmosMsg `json:"msg"`
+	GasLimit *uint64   `json:"gas_limit,omitempty"`
+	ReplyOn  ReplyOn   `json:"reply_on"`
+}
+
+// Reply mirrors `cosmwasm_std::Reply`, passed to a contract's reply entry point.
+type Reply struct {
+	ID     uint64       `json:"id"`
+	Result SubMsgResult `json:"result"`
+}
+
+// SubMsgResult mirrors `cosmwasm_std::SubMsgResult`, either Ok or Err, never both.
+type SubMsgResult struct {
+	Ok  *SubMsgResponse `json:"ok,omitempty"`
+	Err string          `json:"error,omitempty"`
+}
+
+// SubMsgResponse mirrors `cosmwasm_std::SubMsgResponse` returned on successful SubMsg execution.
+type SubMsgResponse struct {
+	Events []Event `json:"events"`
+	// Data is kept for backwards compatibility with contracts compiled against older
+	// cosmwasm_std: it is the first response's proto Data, same as MsgResponses[0] decoded. An IBC
+	// SendPacket dispatches no sdk.Msg: Data then holds the encoded packet sequence and
+	// MsgResponses is empty.
+	Data []byte `json:"data,omitempty"`
+	// MsgResponses carries the protobuf-encoded response of every sdk.Msg the SubMsg fanned out
+	// to, each wrapped as an Any so the reply handler can decode by type URL. A SubMsg that
+	// dispatches N sdk.Msgs (e.g. via a custom encoder that returns several) produces N entries
+	// here, whereas Data only ever carried the first one.
+	MsgResponses []*Any `json:"msg_responses,omitempty"`
+}
+
+// Any is a minimal local mirror of google.protobuf.Any, avoiding a dependency from this wasm-facing
+// package on the SDK's codec types.
+type Any struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+// Event mirrors `cosmwasm_std::Event`. Attributes reuse the v010 wire type since both contract
+// generations encode attributes identically on this side of the wasm boundary.
+type Event struct {
+	Type       string                  `json:"type"`
+	Attributes []v010.LogAttribute `json:"attributes"`
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	wasmTypes "github.com/enigmampc/SecretNetwork/go-cosmwasm/types"
+	v1wasmTypes "github.com/enigmampc/SecretNetwork/go-cosmwasm/types/v1"
+	"github.com/enigmampc/SecretNetwork/x/compute/internal/types"
+)
+
+// ContractIBCPortID derives the IBC port a contract sends on when it did not explicitly bind one
+// of its own, mirroring the "wasm.<contract address>" convention so relayers and existing
+// tooling that watch for that prefix keep working unmodified.
+func ContractIBCPortID(contractAddr sdk.AccAddress) string {
+	return "wasm." + contractAddr.String()
+}
+
+func channelCapabilityName(portID, channelID string) string {
+	return "ports/" + portID + "/channels/" + channelID
+}
+
+// IBCChannelKeeper is the subset of the core IBC channel keeper the dispatcher needs to send raw
+// packets and close channels on a contract's behalf. SendPacket and ChanCloseInit are plain
+// keeper calls, not sdk.Msg / MsgServer routes, which is why they bypass SDKMessageRouter.
+//
+// This matches the ibc-go v4 ChannelKeeper API (the version this module is pinned to): SendPacket
+// takes a fully-assembled packet and returns only an error - the caller is responsible for
+// reading the sequence via GetNextSequenceSend before building the packet.
+type IBCChannelKeeper interface {
+	SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+	ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+}
+
+// IBCCapabilityKeeper looks up the channel capability a contract's port was bound with, which
+// SendPacket/ChanCloseInit need to prove the caller actually owns the channel.
+type IBCCapabilityKeeper interface {
+	GetCapability(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool)
+}
+
+// ibcMsgHandler dispatches CosmosMsg::Ibc. Transfer still goes through the normal
+// encoder+router path (ibc-transfer's MsgTransfer is a regular sdk.Msg); SendPacket and
+// CloseChannel talk to the channel keeper directly, since there is no sdk.Msg response to fall
+// back on. SendPacket synthesizes its own reply data (the packet sequence); CloseChannel returns
+// no data.
+type ibcMsgHandler struct {
+	encoders MessageEncoders
+	router   SDKMessageRouter
+	channel  IBCChannelKeeper
+	scoped   IBCCapabilityKeeper
+}
+
+// NewIBCMsgHandler builds the handler for CosmosMsg::Ibc.
+func NewIBCMsgHandler(encoders MessageEncoders, router SDKMessageRouter, channel IBCChannelKeeper, scoped IBCCapabilityKeeper) SDKMessageHandler {
+	return ibcMsgHandler{encoders: encoders, router: router, channel: channel, scoped: scoped}
+}
+
+func (h ibcMsgHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg v1wasmTypes.CosmosMsg, _ wasmTypes.CosmosMsgVersion) (events []sdk.Event, data [][]byte, msgResponses []*v1wasmTypes.Any, err error) {
+	if msg.Ibc == nil {
+		return nil, nil, nil, ErrUnknownMsg
+	}
+	if contractIBCPortID == "" {
+		contractIBCPortID = ContractIBCPortID(contractAddr)
+	}
+
+	switch {
+	case msg.Ibc.SendPacket != nil:
+		return h.dispatchSendPacket(ctx, contractIBCPortID, msg.Ibc.SendPacket)
+	case msg.Ibc.CloseChannel != nil:
+		return h.dispatchCloseChannel(ctx, contractIBCPortID, msg.Ibc.CloseChannel)
+	case msg.Ibc.Transfer != nil:
+		if h.encoders.IBC == nil {
+			return nil, nil, nil, ErrUnknownMsg
+		}
+		sdkMsgs, err := h.encoders.IBC(ctx, contractAddr, contractIBCPortID, msg.Ibc)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return h.router.Route(ctx, sdkMsgs...)
+	default:
+		return nil, nil, nil, sdkerrors.Wrap(types.ErrInvalid, "unknown ibc message variant")
+	}
+}
+
+// dispatchSendPacket builds a packet carrying msg.Data on a channel already bound to
+// contractIBCPortID, sends it, and returns the assigned sequence number back to the contract as
+// SubMsgResponse.Data so a reply handler can correlate acks/timeouts to the SubMsg ID. The
+// sequence is not wrapped in an Any: ibc-go v4 (which this module is pinned to) has no
+// MsgSendPacket/MsgSendPacketResponse type registered in the codec for a contract to decode
+// against, so fabricating a type URL for it would just fail to resolve on the contract side.
+func (h ibcMsgHandler) dispatchSendPacket(ctx sdk.Context, portID string, msg *v1wasmTypes.SendPacketMsg) ([]sdk.Event, [][]byte, []*v1wasmTypes.Any, error) {
+	chanCap, ok := h.scoped.GetCapability(ctx, channelCapabilityName(portID, msg.ChannelID))
+	if !ok {
+		return nil, nil, nil, sdkerrors.Wrapf(types.ErrInvalid, "no channel capability for port %q channel %q", portID, msg.ChannelID)
+	}
+
+	channel, ok := h.channel.GetChannel(ctx, portID, msg.ChannelID)
+	if !ok {
+		return nil, nil, nil, sdkerrors.Wrapf(types.ErrInvalid, "unknown channel: port %q channel %q", portID, msg.ChannelID)
+	}
+
+	sequence, ok := h.channel.GetNextSequenceSend(ctx, portID, msg.ChannelID)
+	if !ok {
+		return nil, nil, nil, sdkerrors.Wrapf(types.ErrInvalid, "no next sequence send for port %q channel %q", portID, msg.ChannelID)
+	}
+
+	timeoutHeight, timeoutTimestamp := toIBCTimeout(msg.Timeout)
+	packet := channeltypes.NewPacket(
+		msg.Data,
+		sequence,
+		portID, msg.ChannelID,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		timeoutHeight, timeoutTimestamp,
+	)
+
+	if err := h.channel.SendPacket(ctx, chanCap, packet); err != nil {
+		return nil, nil, nil, sdkerrors.Wrap(err, "dispatch ibc send_packet")
+	}
+
+	respData := encodeMsgSendPacketResponse(sequence)
+	return nil, [][]byte{respData}, nil, nil
+}
+
+func (h ibcMsgHandler) dispatchCloseChannel(ctx sdk.Context, portID string, msg *v1wasmTypes.CloseChannelMsg) ([]sdk.Event, [][]byte, []*v1wasmTypes.Any, error) {
+	chanCap, ok := h.scoped.GetCapability(ctx, channelCapabilityName(portID, msg.ChannelID))
+	if !ok {
+		return nil, nil, nil, sdkerrors.Wrapf(types.ErrInvalid, "no channel capability for port %q channel %q", portID, msg.ChannelID)
+	}
+	if err := h.channel.ChanCloseInit(ctx, portID, msg.ChannelID, chanCap); err != nil {
+		return nil, nil, nil, sdkerrors.Wrap(err, "dispatch ibc close_channel")
+	}
+	return nil, nil, nil, nil
+}
+
+func toIBCTimeout(timeout v1wasmTypes.IBCTimeout) (clienttypes.Height, uint64) {
+	if timeout.Block == nil {
+		return clienttypes.ZeroHeight(), timeout.Timestamp
+	}
+	return clienttypes.NewHeight(timeout.Block.Revision, timeout.Block.Height), timeout.Timestamp
+}
+
+// encodeMsgSendPacketResponse hand-encodes the protobuf wire format for a message with a single
+// `uint64 sequence = 1` field, avoiding a dependency on generated code for a one-field response.
+func encodeMsgSendPacketResponse(sequence uint64) []byte {
+	buf := make([]byte, 0, binary.MaxVarintLen64+1)
+	buf = append(buf, 0x08) // field 1, varint wire type
+	return appendVarint(buf, sequence)
+}
+
+func appendVarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+var _ SDKMessageHandler = ibcMsgHandler{}
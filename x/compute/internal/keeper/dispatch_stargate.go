@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	v1wasmTypes "github.com/enigmampc/SecretNetwork/go-cosmwasm/types/v1"
+	"github.com/enigmampc/SecretNetwork/x/compute/internal/types"
+)
+
+// HasSigner is implemented by sdk.Msg types that expose a single required signer, which covers
+// everything we allow a contract to send via Stargate - contracts can only ever act as themselves.
+type HasSigner interface {
+	GetSigners() []sdk.AccAddress
+}
+
+// StargateParamSource is the subset of the keeper's param access the Stargate encoder needs, kept
+// narrow so this file doesn't have to depend on the whole Keeper type.
+type StargateParamSource interface {
+	IsStargateTypeAllowed(ctx sdk.Context, typeURL string) bool
+}
+
+// ParamsKeeper is the subset of the compute keeper that can read the current chain params, e.g.
+// via its param subspace's GetParamSet.
+type ParamsKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+}
+
+// paramsStargateSource adapts a ParamsKeeper into a StargateParamSource by reading fresh params
+// per call - types.Params.IsStargateTypeAllowed itself takes no ctx (it is pure data), but the
+// allow-list it checks against is chain state, so the ctx has to come in here.
+type paramsStargateSource struct {
+	keeper ParamsKeeper
+}
+
+// NewParamsStargateSource builds the StargateParamSource NewStargateEncoder needs from whatever
+// keeper exposes GetParams, so the governance allow-list in types.Params is actually consulted
+// instead of a handler being wired up with nothing behind it.
+func NewParamsStargateSource(keeper ParamsKeeper) StargateParamSource {
+	return paramsStargateSource{keeper: keeper}
+}
+
+func (s paramsStargateSource) IsStargateTypeAllowed(ctx sdk.Context, typeURL string) bool {
+	return s.keeper.GetParams(ctx).IsStargateTypeAllowed(typeURL)
+}
+
+var _ StargateParamSource = paramsStargateSource{}
+
+// NewStargateEncoder builds the MessageEncoders.Stargate function: make sure the type URL is on
+// the governance allow-list, decode the Any into the concrete sdk.Msg registered for that type
+// URL, run ValidateBasic, and check the contract is the message's only signer before
+// handing it to the SDK router. This lets contracts call arbitrary chain modules without a
+// compute-module code change per new msg type, while keeping dispatch deterministic: the same
+// allow-list is consensus state, not a local node flag.
+func NewStargateEncoder(unpacker codectypes.AnyUnpacker, allowlist StargateParamSource) func(ctx sdk.Context, sender sdk.AccAddress, msg *v1wasmTypes.StargateMsg) ([]sdk.Msg, error) {
+	return func(ctx sdk.Context, sender sdk.AccAddress, msg *v1wasmTypes.StargateMsg) ([]sdk.Msg, error) {
+		if !allowlist.IsStargateTypeAllowed(ctx, msg.TypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "type %q not allow-listed for stargate messages", msg.TypeURL)
+		}
+
+		any := &codectypes.Any{
+			TypeUrl: msg.TypeURL,
+			Value:   msg.Value,
+		}
+
+		var sdkMsg sdk.Msg
+		if err := unpacker.UnpackAny(any, &sdkMsg); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalid, "cannot decode stargate message into a registered sdk.Msg")
+		}
+
+		if err := sdkMsg.ValidateBasic(); err != nil {
+			return nil, sdkerrors.Wrap(err, "stargate message failed ValidateBasic")
+		}
+
+		signed, ok := sdkMsg.(HasSigner)
+		if !ok {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "stargate message type %q has no signer", msg.TypeURL)
+		}
+		signers := signed.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(sender) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "contract is not the sole signer of the stargate message")
+		}
+
+		return []sdk.Msg{sdkMsg}, nil
+	}
+}
@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	wasmTypes "github.com/enigmampc/SecretNetwork/go-cosmwasm/types"
+	v1wasmTypes "github.com/enigmampc/SecretNetwork/go-cosmwasm/types/v1"
+	"github.com/enigmampc/SecretNetwork/x/compute/internal/types"
+)
+
+// ErrUnknownMsg is returned by a SDKMessageHandler that does not recognize the CosmosMsg variant
+// it was given, signaling MessageHandlerChain to try the next handler in the chain.
+var ErrUnknownMsg = sdkerrors.Register(types.ModuleName, 3, "unknown message from the contract")
+
+// SDKMessageHandler is one link in a MessageHandlerChain: given a CosmosMsg, it either dispatches
+// the portion of it that it understands, or returns ErrUnknownMsg so the next handler gets a turn.
+type SDKMessageHandler interface {
+	DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg v1wasmTypes.CosmosMsg, ogMessageVersion wasmTypes.CosmosMsgVersion) (events []sdk.Event, data [][]byte, msgResponses []*v1wasmTypes.Any, err error)
+}
+
+// MessageHandlerChain tries each handler in order and returns the result of the first one that
+// claims the message, so chain-specific modules (tokenfactory, ICA, oracle, ...) can be spliced
+// in at app-wiring time without forking the compute module to add a branch per msg type.
+type MessageHandlerChain struct {
+	handlers []SDKMessageHandler
+}
+
+// NewMessageHandlerChain builds a chain from an ordered list of handlers. Earlier handlers take
+// precedence: the first one that does not return ErrUnknownMsg wins.
+func NewMessageHandlerChain(first SDKMessageHandler, others ...SDKMessageHandler) *MessageHandlerChain {
+	return &MessageHandlerChain{handlers: append([]SDKMessageHandler{first}, others...)}
+}
+
+// DispatchMsg implements Messenger by delegating to the chain
+func (m MessageHandlerChain) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg v1wasmTypes.CosmosMsg, ogMessageVersion wasmTypes.CosmosMsgVersion) (events []sdk.Event, data [][]byte, msgResponses []*v1wasmTypes.Any, err error) {
+	for _, h := range m.handlers {
+		events, data, msgResponses, err = h.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg, ogMessageVersion)
+		switch {
+		case errors.Is(err, ErrUnknownMsg):
+			continue
+		case err != nil:
+			return nil, nil, nil, err
+		default:
+			return events, data, msgResponses, nil
+		}
+	}
+	return nil, nil, nil, sdkerrors.Wrap(ErrUnknownMsg, "no handler registered for this message")
+}
+
+var _ Messenger = MessageHandlerChain{}
+
+// MessageEncoders maps each CosmosMsg variant to a function that turns it into one or more
+// sdk.Msg for the SDK router to execute. Custom is keyed by the contract-supplied discriminator
+// inside CosmosMsg::Custom so third-party modules can register their own without forking compute.
+type MessageEncoders struct {
+	Bank         func(ctx sdk.Context, sender sdk.AccAddress, msg *v1wasmTypes.BankMsg) ([]sdk.Msg, error)
+	Staking      func(ctx sdk.Context, sender sdk.AccAddress, msg *v1wasmTypes.StakingMsg) ([]sdk.Msg, error)
+	Distribution func(ctx sdk.Context, sender sdk.AccAddress, msg *v1wasmTypes.DistributionMsg) ([]sdk.Msg, error)
+	Stargate     func(ctx sdk.Context, sender sdk.AccAddress, msg *v1wasmTypes.StargateMsg) ([]sdk.Msg, error)
+	IBC          func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *v1wasmTypes.IBCMsg) ([]sdk.Msg, error)
+	Custom       map[string]func(ctx sdk.Context, sender sdk.AccAddress, msg v1wasmTypes.RawMessage) ([]sdk.Msg, error)
+}
+
+// RegisterCustomEncoder wires a chain-specific module into the Custom slot of CosmosMsg, keyed by
+// a discriminator the contract embeds in the message JSON (e.g. `{"token_factory": {...}}`).
+func (e *MessageEncoders) RegisterCustomEncoder(key string, encoder func(ctx sdk.Context, sender sdk.AccAddress, msg v1wasmTypes.RawMessage) ([]sdk.Msg, error)) {
+	if e.Custom == nil {
+		e.Custom = make(map[string]func(ctx sdk.Context, sender sdk.AccAddress, msg v1wasmTypes.RawMessage) ([]sdk.Msg, error))
+	}
+	e.Custom[key] = encoder
+}
+
+// SDKMessageRouter executes the sdk.Msg(s) an encoder produced and collects the events and
+// responses generated along the way. Kept separate from the encoder functions themselves so the
+// same routing logic is shared by every built-in handler.
+type SDKMessageRouter interface {
+	Route(ctx sdk.Context, msgs ...sdk.Msg) (events []sdk.Event, data [][]byte, msgResponses []*v1wasmTypes.Any, err error)
+}
+
+// customMsgHandler adapts a single MessageEncoders field (e.g. Bank, Staking) into a
+// SDKMessageHandler: encode the relevant CosmosMsg variant into sdk.Msg(s), then route them.
+type customMsgHandler struct {
+	encode func(ctx sdk.Context, sender sdk.AccAddress, msg v1wasmTypes.CosmosMsg) ([]sdk.Msg, bool, error)
+	router SDKMessageRouter
+}
+
+func (h customMsgHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg v1wasmTypes.CosmosMsg, _ wasmTypes.CosmosMsgVersion) (events []sdk.Event, data [][]byte, msgResponses []*v1wasmTypes.Any, err error) {
+	sdkMsgs, handled, err := h.encode(ctx, contractAddr, msg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !handled {
+		return nil, nil, nil, ErrUnknownMsg
+	}
+	return h.router.Route(ctx, sdkMsgs...)
+}
+
+// NewBankMsgHandler builds the handler for CosmosMsg::Bank, dispatching through the given router.
+func NewBankMsgHandler(encoders MessageEncoders, router SDKMessageRouter) SDKMessageHandler {
+	return customMsgHandler{
+		router: router,
+		encode: func(ctx sdk.Context, sender sdk.AccAddress, msg v1wasmTypes.CosmosMsg) ([]sdk.Msg, bool, error) {
+			if msg.Bank == nil || encoders.Bank == nil {
+				return nil, false, nil
+			}
+			sdkMsgs, err := encoders.Bank(ctx, sender, msg.Bank)
+			return sdkMsgs, true, err
+		},
+	}
+}
+
+// NewStakingMsgHandler builds the handler for CosmosMsg::Staking.
+func NewStakingMsgHandler(encoders MessageEncoders, router SDKMessageRouter) SDKMessageHandler {
+	return customMsgHandler{
+		router: router,
+		encode: func(ctx sdk.Context, sender sdk.AccAddress, msg v1wasmTypes.CosmosMsg) ([]sdk.Msg, bool, error) {
+			if msg.Staking == nil || encoders.Staking == nil {
+				return nil, false, nil
+			}
+			sdkMsgs, err := encoders.Staking(ctx, sender, msg.Staking)
+			return sdkMsgs, true, err
+		},
+	}
+}
+
+// NewDistributionMsgHandler builds the handler for CosmosMsg::Distribution.
+func NewDistributionMsgHandler(encoders MessageEncoders, router SDKMessageRouter) SDKMessageHandler {
+	return customMsgHandler{
+		router: router,
+		encode: func(ctx sdk.Context, sender sdk.AccAddress, msg v1wasmTypes.CosmosMsg) ([]sdk.Msg, bool, error) {
+			if msg.Distribution == nil || encoders.Distribution == nil {
+				return nil, false, nil
+			}
+			sdkMsgs, err := encoders.Distribution(ctx, sender, msg.Distribution)
+			return sdkMsgs, true, err
+		},
+	}
+}
+
+// NewStargateMsgHandler builds the handler for CosmosMsg::Stargate and its newer, stable
+// replacement CosmosMsg::Any - both carry a type URL and raw protobuf bytes, so both are routed
+// through the same encoder (allow-list check, decode, ValidateBasic, signer check; see
+// dispatch_stargate.go).
+func NewStargateMsgHandler(encoders MessageEncoders, router SDKMessageRouter) SDKMessageHandler {
+	return customMsgHandler{
+		router: router,
+		encode: func(ctx sdk.Context, sender sdk.AccAddress, msg v1wasmTypes.CosmosMsg) ([]sdk.Msg, bool, error) {
+			stargateMsg := msg.Stargate
+			if stargateMsg == nil && msg.Any != nil {
+				stargateMsg = &v1wasmTypes.StargateMsg{TypeURL: msg.Any.TypeURL, Value: msg.Any.Value}
+			}
+			if stargateMsg == nil || encoders.Stargate == nil {
+				return nil, false, nil
+			}
+			sdkMsgs, err := encoders.Stargate(ctx, sender, stargateMsg)
+			return sdkMsgs, true, err
+		},
+	}
+}
+
+// NewCustomMsgHandler builds the handler for CosmosMsg::Custom, dispatching by the discriminator
+// key registered via MessageEncoders.RegisterCustomEncoder. This is the slot third-party modules
+// (tokenfactory, ICA controller, an oracle module, ...) extend at app-wiring time.
+//
+// A contract could craft a custom payload carrying more than one registered discriminator key
+// (e.g. `{"token_factory": {...}, "ica": {...}}`). Picking "whichever encoder runs first" would be
+// Go's randomized map iteration order, which is nondeterministic across nodes and would diverge
+// apphash. So matches are collected over a fixed, sorted key order and a payload matching more
+// than one registered encoder is rejected outright rather than silently picking one.
+func NewCustomMsgHandler(encoders MessageEncoders, router SDKMessageRouter) SDKMessageHandler {
+	return customMsgHandler{
+		router: router,
+		encode: func(ctx sdk.Context, sender sdk.AccAddress, msg v1wasmTypes.CosmosMsg) ([]sdk.Msg, bool, error) {
+			if msg.Custom == nil || len(encoders.Custom) == 0 {
+				return nil, false, nil
+			}
+
+			var probe map[string]v1wasmTypes.RawMessage
+			if err := json.Unmarshal(*msg.Custom, &probe); err != nil {
+				return nil, false, sdkerrors.Wrap(types.ErrInvalid, "custom message is not a JSON object")
+			}
+
+			keys := make([]string, 0, len(encoders.Custom))
+			for key := range encoders.Custom {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			var matched []string
+			for _, key := range keys {
+				if _, ok := probe[key]; ok {
+					matched = append(matched, key)
+				}
+			}
+
+			switch len(matched) {
+			case 0:
+				return nil, false, nil
+			case 1:
+				sdkMsgs, err := encoders.Custom[matched[0]](ctx, sender, probe[matched[0]])
+				return sdkMsgs, true, err
+			default:
+				return nil, true, sdkerrors.Wrapf(types.ErrInvalid, "custom message matches more than one registered encoder: %v", matched)
+			}
+		},
+	}
+}
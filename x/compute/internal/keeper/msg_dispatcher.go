@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,8 +16,12 @@ import (
 // Messenger is an extension point for custom wasmd message handling
 
 type Messenger interface {
-	// DispatchMsg encodes the wasmVM message and dispatches it.
-	DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg v1wasmTypes.CosmosMsg, ogMessageVersion wasmTypes.CosmosMsgVersion) (events []sdk.Event, data [][]byte, err error)
+	// DispatchMsg encodes the wasmVM message and dispatches it. msgResponses carries the
+	// protobuf-encoded response of every sdk.Msg that was dispatched as a result of msg, in
+	// order, each tagged with its proto type URL so a reply handler can decode by type; data
+	// holds the same responses as raw bytes for callers that only care about the first one. An IBC
+	// SendPacket dispatches no sdk.Msg and returns only data (the packet sequence).
+	DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg v1wasmTypes.CosmosMsg, ogMessageVersion wasmTypes.CosmosMsgVersion) (events []sdk.Event, data [][]byte, msgResponses []*v1wasmTypes.Any, err error)
 }
 
 // Replyer is a subset of keeper that can handle replies to submessages
@@ -26,13 +31,52 @@ type Replyer interface {
 
 // MessageDispatcher coordinates message sending and submessage reply/ state commits
 type MessageDispatcher struct {
-	messenger Messenger
-	keeper    Replyer
+	messenger           Messenger
+	keeper              Replyer
+	gasRegister         types.GasRegister
+	errorMappingVersion uint64
 }
 
-// NewMessageDispatcher constructor
+// NewMessageDispatcher constructor. messenger is typically a *MessageHandlerChain composed of the
+// built-in handlers (see handler_plugin.go) plus any chain-specific ones wired in at app assembly,
+// but any Messenger works. Uses the default gas register unless overridden by WithGasRegister, so
+// callers that don't care about tunable costs keep working unchanged.
 func NewMessageDispatcher(messenger Messenger, keeper Replyer) *MessageDispatcher {
-	return &MessageDispatcher{messenger: messenger, keeper: keeper}
+	gasRegister := types.NewDefaultWasmGasRegister()
+	return &MessageDispatcher{
+		messenger:           messenger,
+		keeper:              keeper,
+		gasRegister:         gasRegister,
+		errorMappingVersion: types.CurrentErrorMappingVersion,
+	}
+}
+
+// WithGasRegister overrides the default gas register, e.g. with one sourced from chain params.
+func (d *MessageDispatcher) WithGasRegister(gasRegister types.GasRegister) *MessageDispatcher {
+	d.gasRegister = gasRegister
+	return d
+}
+
+// WithErrorMappingVersion pins which version of the deterministic error label table (see
+// types.StableErrorLabel) redactError uses, e.g. sourced from types.Params.ErrorMappingVersion.
+func (d *MessageDispatcher) WithErrorMappingVersion(version uint64) *MessageDispatcher {
+	d.errorMappingVersion = version
+	return d
+}
+
+// NewMessageDispatcherFromParams builds a MessageDispatcher configured from the chain's current
+// compute params: the gas register costs (request governing EventCosts/ReplyCosts/etc.) and the
+// error mapping version (governing redactError), both read fresh from paramsKeeper.GetParams(ctx).
+// Without this, the two "tune via governance without a hard fork" params would just be dead
+// fields: NewMessageDispatcher alone always falls back to the hardcoded defaults. Call this at
+// the point a dispatcher is needed for a tx - not once at keeper construction time - so a
+// governance param change (including ErrorMappingUpgradeHandler bumping ErrorMappingVersion) takes
+// effect on the very next block rather than only after a restart.
+func NewMessageDispatcherFromParams(ctx sdk.Context, messenger Messenger, keeper Replyer, paramsKeeper ParamsKeeper) *MessageDispatcher {
+	params := paramsKeeper.GetParams(ctx)
+	return NewMessageDispatcher(messenger, keeper).
+		WithGasRegister(types.NewWasmGasRegister(params.GasRegisterConfig)).
+		WithErrorMappingVersion(params.ErrorMappingVersion)
 }
 
 func filterEvents(events []sdk.Event) []sdk.Event {
@@ -57,6 +101,17 @@ func sdkAttributesToWasmVMAttributes(attrs []abci.EventAttribute) []v010wasmType
 	return res
 }
 
+// flattenEventAttributes collects the attributes of every event into a single slice, so gas
+// accounting (see types.GasRegister.EventCosts) applies its free tier once across the whole set
+// rather than once per event.
+func flattenEventAttributes(events []v1wasmTypes.Event) []v010wasmTypes.LogAttribute {
+	var attrs []v010wasmTypes.LogAttribute
+	for _, ev := range events {
+		attrs = append(attrs, ev.Attributes...)
+	}
+	return attrs
+}
+
 func sdkEventsToWasmVMEvents(events []sdk.Event) []v1wasmTypes.Event {
 	res := make([]v1wasmTypes.Event, len(events))
 	for i, ev := range events {
@@ -69,7 +124,7 @@ func sdkEventsToWasmVMEvents(events []sdk.Event) []v1wasmTypes.Event {
 }
 
 // dispatchMsgWithGasLimit sends a message with gas limit applied
-func (d MessageDispatcher) dispatchMsgWithGasLimit(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msg v1wasmTypes.CosmosMsg, gasLimit uint64, ogCosmosMessageVersion wasmTypes.CosmosMsgVersion) (events []sdk.Event, data [][]byte, err error) {
+func (d MessageDispatcher) dispatchMsgWithGasLimit(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msg v1wasmTypes.CosmosMsg, gasLimit uint64, ogCosmosMessageVersion wasmTypes.CosmosMsgVersion) (events []sdk.Event, data [][]byte, msgResponses []*v1wasmTypes.Any, err error) {
 	limitedMeter := sdk.NewGasMeter(gasLimit)
 	subCtx := ctx.WithGasMeter(limitedMeter)
 
@@ -86,13 +141,13 @@ func (d MessageDispatcher) dispatchMsgWithGasLimit(ctx sdk.Context, contractAddr
 			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "SubMsg hit gas limit")
 		}
 	}()
-	events, data, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg, ogCosmosMessageVersion)
+	events, data, msgResponses, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg, ogCosmosMessageVersion)
 
 	// make sure we charge the parent what was spent
 	spent := subCtx.GasMeter().GasConsumed()
 	ctx.GasMeter().ConsumeGas(spent, "From limited Sub-Message")
 
-	return events, data, err
+	return events, data, msgResponses, err
 }
 
 type InvalidRequest struct {
@@ -140,21 +195,22 @@ func isReplyEncrypted(msg v1wasmTypes.CosmosMsg, reply v1wasmTypes.Reply) bool {
 	return (msg.Wasm != nil) && (reply.Result.Ok != nil)
 }
 
-// Issue #759 - we don't return error string for worries of non-determinism
-func redactError(err error) error {
+// Issue #759 - we don't return error string for worries of non-determinism. redactError used to
+// drop all error text and return only `codespace:code`, which left contract reply handlers unable
+// to distinguish causes (insufficient funds vs. unauthorized vs. invalid address) without parsing
+// a raw numeric code. It now also emits a stable, curated label for whichever (codespace, code)
+// pairs are recognized by the mapping table pinned at errorMappingVersion (bumped only through
+// governance, see types.Params.ErrorMappingVersion), so contracts can branch on Reply::Err without
+// the non-determinism risk of forwarding the SDK's free-text error wording.
+func redactError(err error, errorMappingVersion uint64) error {
 	// Do not redact system errors
 	// SystemErrors must be created in x/wasm and we can ensure determinism
 	if wasmTypes.ToSystemError(err) != nil {
 		return err
 	}
 
-	// FIXME: do we want to hardcode some constant string mappings here as well?
-	// Or better document them? (SDK error string may change on a patch release to fix wording)
-	// sdk/11 is out of gas
-	// sdk/5 is insufficient funds (on bank send)
-	// (we can theoretically redact less in the future, but this is a first step to safety)
 	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
-	return fmt.Errorf("codespace: %s, code: %d", codespace, code)
+	return errors.New(types.FormatRedactedError(errorMappingVersion, codespace, code))
 }
 
 // DispatchSubmessages builds a sandbox to execute these messages and returns the execution result to the contract
@@ -181,10 +237,11 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		var err error
 		var events []sdk.Event
 		var data [][]byte
+		var msgResponses []*v1wasmTypes.Any
 		if limitGas {
-			events, data, err = d.dispatchMsgWithGasLimit(subCtx, contractAddr, ibcPort, msg.Msg, *msg.GasLimit, ogCosmosMessageVersion)
+			events, data, msgResponses, err = d.dispatchMsgWithGasLimit(subCtx, contractAddr, ibcPort, msg.Msg, *msg.GasLimit, ogCosmosMessageVersion)
 		} else {
-			events, data, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg.Msg, ogCosmosMessageVersion)
+			events, data, msgResponses, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg.Msg, ogCosmosMessageVersion)
 		}
 
 		// if it succeeds, commit state changes from submessage, and pass on events to Event Manager
@@ -192,6 +249,13 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		if err == nil {
 			commit()
 			filteredEvents = filterEvents(append(em.Events(), events...))
+			// charge deterministically for the attribute bytes we are about to persist, so cost
+			// tracks what the enclave actually spent re-encoding and forwarding these events. The
+			// free tier applies once across all attributes of all events, not per event, so flatten
+			// before calling EventCosts instead of calling it once per event. This is the only place
+			// these events are charged for - ReplyCosts below charges the reply dispatch itself, not
+			// the events a second time.
+			ctx.GasMeter().ConsumeGas(d.gasRegister.EventCosts(flattenEventAttributes(sdkEventsToWasmVMEvents(filteredEvents))), "Sub-Message event attributes")
 			ctx.EventManager().EmitEvents(filteredEvents)
 		} // on failure, revert state from sandbox, and ignore events (just skip doing the above)
 
@@ -210,8 +274,9 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		// We need to create a SubMsgResult and pass it into the calling contract
 		var result v1wasmTypes.SubMsgResult
 		if err == nil {
-			// just take the first one for now if there are multiple sub-sdk messages
-			// and safely return nothing if no data
+			// Data keeps the first sdk.Msg's response for contracts compiled against older
+			// cosmwasm_std that only ever looked at data[0]; MsgResponses below carries all of
+			// them so newer reply handlers can decode every dispatched sdk.Msg by type URL.
 			var responseData []byte
 			if len(data) > 0 {
 				responseData = data[0]
@@ -219,15 +284,16 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 			result = v1wasmTypes.SubMsgResult{
 				// Copy first 64 bytes of the OG message in order to preserve the pubkey.
 				Ok: &v1wasmTypes.SubMsgResponse{
-					Events: sdkEventsToWasmVMEvents(filteredEvents),
-					Data:   responseData,
+					Events:       sdkEventsToWasmVMEvents(filteredEvents),
+					Data:         responseData,
+					MsgResponses: msgResponses,
 				},
 			}
 		} else {
 			// Issue #759 - we don't return error string for worries of non-determinism
 			moduleLogger(ctx).Info("Redacting submessage error", "cause", err)
 			result = v1wasmTypes.SubMsgResult{
-				Err: redactError(err).Error(),
+				Err: redactError(err, d.errorMappingVersion).Error(),
 			}
 		}
 
@@ -237,6 +303,10 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 			Result: result,
 		}
 
+		// charge for the reply dispatch itself (enclave re-entry, data marshaling) before invoking
+		// the contract's reply entry point, so cost doesn't depend on whether ReplyCosts is implicit
+		ctx.GasMeter().ConsumeGas(d.gasRegister.ReplyCosts(false, reply), "Sub-Message reply")
+
 		// we can ignore any result returned as there is nothing to do with the data
 		// and the events are already in the ctx.EventManager()
 
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errorMappingKey identifies an SDK error by its (codespace, code) pair, the same pair
+// sdkerrors.ABCIInfo already extracts deterministically from any wrapped error.
+type errorMappingKey struct {
+	codespace string
+	code      uint32
+}
+
+// errorMappingTable is a versioned, append-only map from (codespace, code) to a stable,
+// machine-readable label. Versions are pinned per consensus upgrade (see
+// CurrentErrorMappingVersion) so that adding new mappings never changes what an existing chain
+// height redacts to - nodes on different binary versions but the same configured version must
+// redact identically.
+var errorMappingTable = map[uint64]map[errorMappingKey]string{
+	1: {
+		{sdkerrors.ErrInsufficientFunds.Codespace(), sdkerrors.ErrInsufficientFunds.ABCICode()}: "insufficient_funds",
+		{sdkerrors.ErrUnauthorized.Codespace(), sdkerrors.ErrUnauthorized.ABCICode()}:            "unauthorized",
+		{sdkerrors.ErrInvalidAddress.Codespace(), sdkerrors.ErrInvalidAddress.ABCICode()}:        "invalid_address",
+		{sdkerrors.ErrInvalidRequest.Codespace(), sdkerrors.ErrInvalidRequest.ABCICode()}:        "invalid_request",
+		{sdkerrors.ErrOutOfGas.Codespace(), sdkerrors.ErrOutOfGas.ABCICode()}:                    "out_of_gas",
+		{ErrInvalid.Codespace(), ErrInvalid.ABCICode()}:                                         "invalid",
+	},
+}
+
+// CurrentErrorMappingVersion is the version used when no chain param has been set yet (e.g. on a
+// fresh genesis, before the first governance-driven bump). Params.ErrorMappingVersion overrides
+// this per chain.
+const CurrentErrorMappingVersion uint64 = 1
+
+// unknownRequestLabel is returned for any (codespace, code) pair the pinned version's table
+// doesn't recognize, so contracts always get a stable string to branch on rather than nothing.
+const unknownRequestLabel = "unknown_request"
+
+// StableErrorLabel looks up the deterministic label for an SDK error's (codespace, code) under
+// the given mapping version, falling back to unknownRequestLabel for anything not in that
+// version's table (including versions that don't exist, e.g. a node that hasn't upgraded yet).
+func StableErrorLabel(version uint64, codespace string, code uint32) string {
+	table, ok := errorMappingTable[version]
+	if !ok {
+		return unknownRequestLabel
+	}
+	label, ok := table[errorMappingKey{codespace: codespace, code: code}]
+	if !ok {
+		return unknownRequestLabel
+	}
+	return label
+}
+
+// FormatRedactedError renders the codespace/code together with its stable label, e.g.
+// `codespace: sdk, code: 5, label: insufficient_funds`, so contract reply handlers can branch on
+// `label` while the raw codespace/code remains available for off-chain debugging.
+func FormatRedactedError(version uint64, codespace string, code uint32) string {
+	return fmt.Sprintf("codespace: %s, code: %d, label: %s", codespace, code, StableErrorLabel(version, codespace, code))
+}
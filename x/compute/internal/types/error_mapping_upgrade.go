@@ -0,0 +1,13 @@
+package types
+
+// ErrorMappingUpgradeHandler bumps the chain's configured error mapping version, for use from an
+// app-level upgrade handler (app/upgrades/.../upgrades.go) when a new error mapping table version
+// is added to errorMappingTable in error_mapping.go. It rejects versions the running binary
+// doesn't know about so an upgrade can never point the chain at a table that doesn't exist yet.
+func ErrorMappingUpgradeHandler(params Params, newVersion uint64) (Params, error) {
+	if err := validateErrorMappingVersion(newVersion); err != nil {
+		return params, err
+	}
+	params.ErrorMappingVersion = newVersion
+	return params, nil
+}
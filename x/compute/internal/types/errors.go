@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/compute module sentinel errors
+var (
+	ErrInvalid = sdkerrors.Register(ModuleName, 2, "invalid")
+)
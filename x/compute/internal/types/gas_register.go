@@ -0,0 +1,175 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v010wasmTypes "github.com/enigmampc/SecretNetwork/go-cosmwasm/types/v010"
+	v1wasmTypes "github.com/enigmampc/SecretNetwork/go-cosmwasm/types/v1"
+)
+
+// DefaultGasMultiplier is how many CosmWasm gas points = 1 sdk gas point.
+// SDK reference costs are usually in the range of 100-1000 per op, so in the same range
+// as our gas points, so this is a very easy conversion rate for readable values, but may
+// need tuning to adjust for the ratio of CPU used by actual wasm vs sdk operations.
+const DefaultGasMultiplier uint64 = 140_000_000
+
+// DefaultInstanceCost is how much SDK gas we charge each time we load a WASM instance, even before running anything.
+// This is the overhead for creating a new instance and running the "setup" code (essentially the module parsing
+// and instantiation of the VM runtime).
+const DefaultInstanceCost uint64 = 60_000
+
+// DefaultCompileCost is how much SDK gas we charge *per byte* for compiling a new contract to WASM bytecode.
+const DefaultCompileCost uint64 = 2
+
+// DefaultEventAttributeDataCost is how much SDK gas we charge per byte for attribute data in events.
+const DefaultEventAttributeDataCost uint64 = 1
+
+// DefaultContractMessageDataCost is how much SDK gas we charge *per byte* of the message that goes to the contract.
+// This is used to discourage sending unnecessarily large messages.
+const DefaultContractMessageDataCost uint64 = 0
+
+// EventAttributeDataFreeTier number of bytes of attribute data we do not charge gas for.
+const EventAttributeDataFreeTier = 100
+
+// DefaultPerAttributeCost is how much SDK gas we charge per attribute in an event, on top of the byte cost.
+const DefaultPerAttributeCost uint64 = 10
+
+// DefaultPerCustomEventCost is the default CustomEventCost. WasmGasRegister.EventCosts does not
+// apply it: events are charged per attribute and per attribute byte only.
+const DefaultPerCustomEventCost uint64 = 20
+
+// DefaultReplyCost is how much SDK gas we charge for handling a reply from a submessage, on top of whatever
+// the reply handler itself consumes.
+const DefaultReplyCost uint64 = 40_000
+
+// GasRegister abstracts source for gas costs. It allows the compute module to charge deterministic
+// gas for operations that are otherwise implicit (e.g. reply dispatch, event attribute bytes), so
+// operators can tune the multipliers via governance without forking the enclave gas meter itself.
+type GasRegister interface {
+	// NewContractInstanceCosts costs to create a new contract instance from code
+	NewContractInstanceCosts(pinned bool, msgLen int) sdk.Gas
+	// CompileCosts costs to compile a new wasm contract
+	CompileCosts(byteLength int) sdk.Gas
+	// EventCosts costs to persist the attributes of events emitted by a contract or the SDK
+	EventCosts(attrs []v010wasmTypes.LogAttribute) sdk.Gas
+	// ReplyCosts costs to handle a message reply; the reply's events are not charged again here
+	ReplyCosts(pinned bool, reply v1wasmTypes.Reply) sdk.Gas
+	// ToWasmVMGas converts from sdk gas to wasmvm gas
+	ToWasmVMGas(source sdk.Gas) uint64
+	// FromWasmVMGas converts from wasmvm gas to sdk gas
+	FromWasmVMGas(source uint64) sdk.Gas
+}
+
+// WasmGasRegisterConfig config type
+type WasmGasRegisterConfig struct {
+	// InstanceCost costs when interacting with a wasm instance
+	InstanceCost sdk.Gas
+	// CompileCost costs to persist and "compile" a new wasm contract, per byte
+	CompileCost sdk.Gas
+	// GasMultiplier is how many CosmWasm gas points = 1 sdk gas point.
+	GasMultiplier sdk.Gas
+	// EventPerAttributeCost is how much sdk gas is charged per event attribute, flat fee
+	EventPerAttributeCost sdk.Gas
+	// EventAttributeDataCost is how much sdk gas is charged per byte of event attribute data, after the free tier
+	EventAttributeDataCost sdk.Gas
+	// EventAttributeDataFreeTier number of bytes of total attribute data we do not charge gas for
+	EventAttributeDataFreeTier uint64
+	// CustomEventCost is how much sdk gas is charged per custom event emitted by a contract
+	CustomEventCost sdk.Gas
+	// ReplyCost is the flat cost for dispatching a single reply
+	ReplyCost sdk.Gas
+}
+
+// DefaultGasRegisterConfig returns the default values used when no params override them
+func DefaultGasRegisterConfig() WasmGasRegisterConfig {
+	return WasmGasRegisterConfig{
+		InstanceCost:               DefaultInstanceCost,
+		CompileCost:                DefaultCompileCost,
+		GasMultiplier:              DefaultGasMultiplier,
+		EventPerAttributeCost:      DefaultPerAttributeCost,
+		EventAttributeDataCost:     DefaultEventAttributeDataCost,
+		EventAttributeDataFreeTier: EventAttributeDataFreeTier,
+		CustomEventCost:            DefaultPerCustomEventCost,
+		ReplyCost:                  DefaultReplyCost,
+	}
+}
+
+// WasmGasRegister implements GasRegister with a configurable cost table. The configured values
+// are sourced from chain params so they can be tuned via governance without a hard fork.
+type WasmGasRegister struct {
+	c WasmGasRegisterConfig
+}
+
+// NewDefaultWasmGasRegister creates a new instance with default values
+func NewDefaultWasmGasRegister() WasmGasRegister {
+	return NewWasmGasRegister(DefaultGasRegisterConfig())
+}
+
+// NewWasmGasRegister creates a new instance with the given config
+func NewWasmGasRegister(c WasmGasRegisterConfig) WasmGasRegister {
+	return WasmGasRegister{c: c}
+}
+
+// NewContractInstanceCosts costs to create a new contract instance from code
+func (g WasmGasRegister) NewContractInstanceCosts(pinned bool, msgLen int) sdk.Gas {
+	dataCosts := sdk.Gas(msgLen) * g.c.EventAttributeDataCost
+	if pinned {
+		return dataCosts
+	}
+	return g.c.InstanceCost + dataCosts
+}
+
+// CompileCosts costs to compile a new wasm contract
+func (g WasmGasRegister) CompileCosts(byteLength int) sdk.Gas {
+	if byteLength < 0 {
+		panic(sdkerrors.Wrap(ErrInvalid, "negative length"))
+	}
+	return g.c.CompileCost * sdk.Gas(byteLength)
+}
+
+// EventCosts costs to persist the attributes of events emitted by a contract or the SDK
+func (g WasmGasRegister) EventCosts(attrs []v010wasmTypes.LogAttribute) sdk.Gas {
+	if len(attrs) == 0 {
+		return 0
+	}
+	gas := sdk.Gas(len(attrs)) * g.c.EventPerAttributeCost
+
+	var totalBytes uint64
+	for _, attr := range attrs {
+		totalBytes += uint64(len(attr.Key)) + uint64(len(attr.Value))
+	}
+	if totalBytes <= g.c.EventAttributeDataFreeTier {
+		return gas
+	}
+	chargedBytes := totalBytes - g.c.EventAttributeDataFreeTier
+	return gas + sdk.Gas(chargedBytes)*g.c.EventAttributeDataCost
+}
+
+// ReplyCosts costs to handle a message reply, on top of whatever the reply entry point itself
+// consumes. It intentionally does NOT re-charge for reply.Result.Ok.Events: those are the same
+// submessage events the dispatcher already ran through EventCosts when it emitted them (see
+// DispatchSubmessages), and charging them again here would double-bill every submessage that
+// gets a reply.
+func (g WasmGasRegister) ReplyCosts(pinned bool, reply v1wasmTypes.Reply) sdk.Gas {
+	gas := g.c.ReplyCost
+	if !pinned {
+		gas += g.c.InstanceCost
+	}
+	return gas
+}
+
+// ToWasmVMGas converts from sdk gas to wasmvm gas
+func (g WasmGasRegister) ToWasmVMGas(source sdk.Gas) uint64 {
+	x := source * g.c.GasMultiplier
+	if x < source {
+		panic(sdkerrors.Wrap(ErrInvalid, "overflow in gas conversion"))
+	}
+	return x
+}
+
+// FromWasmVMGas converts from wasmvm gas to sdk gas
+func (g WasmGasRegister) FromWasmVMGas(source uint64) sdk.Gas {
+	return source / g.c.GasMultiplier
+}
+
+var _ GasRegister = WasmGasRegister{}
@@ -0,0 +1,149 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys for the tunable gas register costs. These are exposed as chain params
+// so operators can retune reply/event/compile costs via governance without a hard fork.
+var (
+	ParamStoreKeyGasMultiplier              = []byte("GasMultiplier")
+	ParamStoreKeyInstanceCost               = []byte("InstanceCost")
+	ParamStoreKeyCompileCost                = []byte("CompileCost")
+	ParamStoreKeyEventPerAttributeCost      = []byte("EventPerAttributeCost")
+	ParamStoreKeyEventAttributeDataCost     = []byte("EventAttributeDataCost")
+	ParamStoreKeyEventAttributeDataFreeTier = []byte("EventAttributeDataFreeTier")
+	ParamStoreKeyCustomEventCost            = []byte("CustomEventCost")
+	ParamStoreKeyReplyCost                  = []byte("ReplyCost")
+	ParamStoreKeyStargateAllowlist          = []byte("StargateAllowlist")
+	ParamStoreKeyErrorMappingVersion        = []byte("ErrorMappingVersion")
+)
+
+// Params defines the set of compute module parameters, including the gas register cost table.
+type Params struct {
+	GasRegisterConfig WasmGasRegisterConfig `json:"gas_register_config" yaml:"gas_register_config"`
+	// StargateAllowlist is the set of protobuf type URLs a contract may target via
+	// CosmosMsg::Stargate. Empty by default: stargate messages are denied until governance
+	// explicitly allows each type URL, so adding a new module to the chain never silently
+	// exposes it to contracts.
+	StargateAllowlist []string `json:"stargate_allowlist" yaml:"stargate_allowlist"`
+	// ErrorMappingVersion selects which version of the (codespace, code) -> stable label table in
+	// error_mapping.go is used to redact submessage errors. Bumped only through governance, so a
+	// new mapping never changes what an existing chain height redacts to for nodes that haven't
+	// adopted it yet.
+	ErrorMappingVersion uint64 `json:"error_mapping_version" yaml:"error_mapping_version"`
+}
+
+// ParamKeyTable returns the param key table for the compute module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the params.ParamSet interface, wiring each gas register field to its
+// own store key so individual costs can be tuned independently via governance proposals.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyGasMultiplier, &p.GasRegisterConfig.GasMultiplier, validateGasMultiplier),
+		paramtypes.NewParamSetPair(ParamStoreKeyInstanceCost, &p.GasRegisterConfig.InstanceCost, validateGasCost),
+		paramtypes.NewParamSetPair(ParamStoreKeyCompileCost, &p.GasRegisterConfig.CompileCost, validateGasCost),
+		paramtypes.NewParamSetPair(ParamStoreKeyEventPerAttributeCost, &p.GasRegisterConfig.EventPerAttributeCost, validateGasCost),
+		paramtypes.NewParamSetPair(ParamStoreKeyEventAttributeDataCost, &p.GasRegisterConfig.EventAttributeDataCost, validateGasCost),
+		paramtypes.NewParamSetPair(ParamStoreKeyEventAttributeDataFreeTier, &p.GasRegisterConfig.EventAttributeDataFreeTier, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyCustomEventCost, &p.GasRegisterConfig.CustomEventCost, validateGasCost),
+		paramtypes.NewParamSetPair(ParamStoreKeyReplyCost, &p.GasRegisterConfig.ReplyCost, validateGasCost),
+		paramtypes.NewParamSetPair(ParamStoreKeyStargateAllowlist, &p.StargateAllowlist, validateStargateAllowlist),
+		paramtypes.NewParamSetPair(ParamStoreKeyErrorMappingVersion, &p.ErrorMappingVersion, validateErrorMappingVersion),
+	}
+}
+
+// DefaultParams returns the default compute module params, sourced from the default gas register
+// config. StargateAllowlist defaults to empty, i.e. deny-all, per Issue discussion on determinism:
+// operators opt individual type URLs in via governance rather than opting risky ones out.
+func DefaultParams() Params {
+	return Params{
+		GasRegisterConfig:   DefaultGasRegisterConfig(),
+		StargateAllowlist:   []string{},
+		ErrorMappingVersion: CurrentErrorMappingVersion,
+	}
+}
+
+func validateErrorMappingVersion(i interface{}) error {
+	version, ok := i.(uint64)
+	if !ok {
+		return ErrInvalid
+	}
+	if _, known := errorMappingTable[version]; !known {
+		return sdkerrors.Wrapf(ErrInvalid, "unknown error mapping version: %d", version)
+	}
+	return nil
+}
+
+// IsStargateTypeAllowed reports whether contracts may target the given protobuf type URL via
+// CosmosMsg::Stargate.
+func (p Params) IsStargateTypeAllowed(typeURL string) bool {
+	for _, allowed := range p.StargateAllowlist {
+		if allowed == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+func validateStargateAllowlist(i interface{}) error {
+	allowlist, ok := i.([]string)
+	if !ok {
+		return ErrInvalid
+	}
+	seen := make(map[string]struct{}, len(allowlist))
+	for _, typeURL := range allowlist {
+		if typeURL == "" {
+			return sdkerrors.Wrap(ErrInvalid, "empty type url in stargate allowlist")
+		}
+		if _, dup := seen[typeURL]; dup {
+			return sdkerrors.Wrap(ErrInvalid, "duplicate type url in stargate allowlist: "+typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+	return nil
+}
+
+// Validate performs basic validation of the compute module params
+func (p Params) Validate() error {
+	if err := validateGasMultiplier(p.GasRegisterConfig.GasMultiplier); err != nil {
+		return err
+	}
+	if err := validateStargateAllowlist(p.StargateAllowlist); err != nil {
+		return err
+	}
+	return validateErrorMappingVersion(p.ErrorMappingVersion)
+}
+
+func validateGasCost(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return ErrInvalid
+	}
+	return nil
+}
+
+// validateGasMultiplier is the ParamStoreKeyGasMultiplier pair's dedicated validator. A single
+// param-change proposal updates this pair through its own validator only - Params.Validate is
+// never consulted - so the zero-rejection has to live here, not just in Validate, or a governance
+// proposal can set GasMultiplier to 0 and brick gas conversion (WasmGasRegister.ToWasmVMGas panics
+// on overflow, FromWasmVMGas divides by zero) the next time any contract runs.
+func validateGasMultiplier(i interface{}) error {
+	if err := validateGasCost(i); err != nil {
+		return err
+	}
+	if i.(uint64) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "gas multiplier must not be zero")
+	}
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return ErrInvalid
+	}
+	return nil
+}